@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import "go.opentelemetry.io/otel/api/kv"
+
+// instrumentationName is used when obtaining a Tracer and Meter from the
+// global providers so that the two can be correlated back to this package.
+const instrumentationName = "go.opentelemetry.io/contrib/github.com/gocql/gocql"
+
+// Span names used for the spans created by this package.
+const (
+	cassQueryName      = "cassandra.query"
+	cassBatchQueryName = "cassandra.batch.query"
+	cassConnectName    = "cassandra.connect"
+	cassClusterName    = "cassandra.cluster"
+)
+
+// Attribute keys that follow the OpenTelemetry semantic conventions for
+// database client calls, specialized for Cassandra/gocql.
+var (
+	// CassVersionKey is set to the CQL protocol version negotiated with the host.
+	CassVersionKey = kv.Key("db.cassandra.version")
+	// CassHostKey is set to the address of the host a query/connection targeted.
+	CassHostKey = kv.Key("db.cassandra.host")
+	// CassPortKey is set to the port of the host a query/connection targeted.
+	CassPortKey = kv.Key("db.cassandra.port")
+	// CassHostStateKey is set to the state (UP/DOWN) of the host at the time of the call.
+	CassHostStateKey = kv.Key("db.cassandra.host_state")
+	// CassKeyspaceKey is set to the keyspace a query/batch was executed against.
+	CassKeyspaceKey = kv.Key("db.cassandra.keyspace")
+	// CassConsistencyKey is set to the consistency level used for the call.
+	CassConsistencyKey = kv.Key("db.cassandra.consistency_level")
+	// CassStatementKey is set to the CQL text of a single query.
+	CassStatementKey = kv.Key("db.statement")
+	// CassBatchStatementsKey is set to the CQL text of every statement in a batch.
+	CassBatchStatementsKey = kv.Key("db.cassandra.batch_statements")
+	// CassTableKey is set to the table a query or batch statement targets.
+	CassTableKey = kv.Key("db.cassandra.table")
+	// CassDCKey is set to the data center of a host involved in a call or
+	// topology event.
+	CassDCKey = kv.Key("cass.dc")
+	// CassRackKey is set to the rack of a host involved in a call or
+	// topology event.
+	CassRackKey = kv.Key("cass.rack")
+	// CassHostIDKey is set to the host ID of a host involved in a call or
+	// topology event.
+	CassHostIDKey = kv.Key("cass.host_id")
+
+	// DBSystemKey identifies the database product, always "cassandra" for this package.
+	DBSystemKey = kv.Key("db.system")
+	// DBNameKey is the keyspace the call was made against, mirrored from CassKeyspaceKey
+	// to satisfy the generic database semantic conventions.
+	DBNameKey = kv.Key("db.name")
+	// DBOperationKey is set to the operation: the leading CQL verb of a
+	// query's statement (SELECT, INSERT, ...), BATCH for batches, CONNECT
+	// for connection attempts, or the event name for host state changes.
+	DBOperationKey = kv.Key("db.operation")
+	// DBErrorKey is set to a coarse classification of the error returned, if any.
+	DBErrorKey = kv.Key("db.cassandra.error")
+)
+
+// dbSystemCassandra is the fixed value recorded under DBSystemKey.
+const dbSystemCassandra = "cassandra"
@@ -36,6 +36,7 @@ import (
 
 	otelGocql "go.opentelemetry.io/contrib/github.com/gocql/gocql"
 	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/metric"
 	"go.opentelemetry.io/otel/exporters/metric/prometheus"
 	zipkintrace "go.opentelemetry.io/otel/exporters/trace/zipkin"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -44,8 +45,9 @@ import (
 var logger = log.New(os.Stderr, "zipkin-example", log.Ldate|log.Ltime|log.Llongfile)
 var wg sync.WaitGroup
 
-func initMetrics() {
-	// Start prometheus
+// initMetrics starts the prometheus exporter and returns a Meter that can be
+// handed to otelGocql.WithMeter to record query/batch/connect metrics.
+func initMetrics() metric.Meter {
 	metricExporter, err := prometheus.NewExportPipeline(prometheus.Config{})
 	if err != nil {
 		logger.Fatalf("failed to install metric exporter, %v", err)
@@ -70,7 +72,7 @@ func initMetrics() {
 		}
 	}()
 
-	otelGocql.InstrumentWithProvider(metricExporter.Provider())
+	return metricExporter.Provider().Meter("gocql-example")
 }
 
 func initTracer() {
@@ -107,7 +109,7 @@ func getCluster() *gocql.ClusterConfig {
 }
 
 func main() {
-	initMetrics()
+	meter := initMetrics()
 	initTracer()
 
 	ctx, span := global.Tracer(
@@ -118,6 +120,7 @@ func main() {
 	// Create a session to begin making queries
 	session, err := otelGocql.NewSessionWithTracing(
 		cluster,
+		otelGocql.WithMeter(meter),
 	)
 	if err != nil {
 		log.Fatalf("failed to create a session, %v", err)
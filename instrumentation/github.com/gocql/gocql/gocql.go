@@ -0,0 +1,383 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gocql provides OpenTelemetry instrumentation for the
+// github.com/gocql/gocql Cassandra driver. It wraps gocql.Session so that
+// queries, batches, and connection attempts are recorded as spans, and
+// optionally as RED-style metrics.
+package gocql
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/metric"
+	"go.opentelemetry.io/otel/api/propagation"
+	"go.opentelemetry.io/otel/api/trace"
+)
+
+// traceOptions holds the configuration assembled from the Option values
+// passed to NewSessionWithTracing.
+type traceOptions struct {
+	tracer trace.Tracer
+	meter  metric.Meter
+
+	connectInstrumentation   bool
+	connectObserver          gocql.ConnectObserver
+	queryObserver            gocql.QueryObserver
+	batchObserver            gocql.BatchObserver
+	hostStateInstrumentation bool
+
+	captureStatements bool
+	sanitizer         func(string) string
+
+	propagator propagation.Propagators
+}
+
+// Option allows for managing gocql configuration using functional options.
+type Option func(o *traceOptions)
+
+// WithTracer sets the tracer used to create the spans for queries, batches,
+// and connection attempts. If this option isn't used, the tracer is
+// obtained from the global trace provider.
+func WithTracer(tracer trace.Tracer) Option {
+	return func(o *traceOptions) {
+		o.tracer = tracer
+	}
+}
+
+// WithMeter sets the meter used to record latency, call count, and error
+// count metrics for queries, batches, and connection attempts. Metrics are
+// only recorded when a meter has been supplied, either through this option
+// or the global meter provider.
+func WithMeter(meter metric.Meter) Option {
+	return func(o *traceOptions) {
+		o.meter = meter
+	}
+}
+
+// WithConnectInstrumentation determines whether spans and metrics are
+// recorded for connection attempts to Cassandra hosts. Connect
+// instrumentation is enabled by default.
+func WithConnectInstrumentation(enabled bool) Option {
+	return func(o *traceOptions) {
+		o.connectInstrumentation = enabled
+	}
+}
+
+// WithConnectObserver sets a gocql.ConnectObserver that is notified, in
+// addition to this package's own tracing observer, whenever a connection
+// attempt completes.
+func WithConnectObserver(observer gocql.ConnectObserver) Option {
+	return func(o *traceOptions) {
+		o.connectObserver = observer
+	}
+}
+
+// WithQueryObserver sets a gocql.QueryObserver that is notified, in addition
+// to this package's own tracing observer, whenever a query completes. This
+// lets callers keep using an existing QueryObserver (for logging, APM,
+// metrics, ...) instead of having to choose between it and tracing.
+func WithQueryObserver(observer gocql.QueryObserver) Option {
+	return func(o *traceOptions) {
+		o.queryObserver = observer
+	}
+}
+
+// WithBatchObserver sets a gocql.BatchObserver that is notified, in addition
+// to this package's own tracing observer, whenever a batch completes. This
+// lets callers keep using an existing BatchObserver (for logging, APM,
+// metrics, ...) instead of having to choose between it and tracing.
+func WithBatchObserver(observer gocql.BatchObserver) Option {
+	return func(o *traceOptions) {
+		o.batchObserver = observer
+	}
+}
+
+// WithStatementSanitizer sets the function used to sanitize CQL statement
+// text before it is recorded on CassStatementKey and
+// CassBatchStatementsKey. By default, defaultStatementSanitizer is used,
+// which replaces string, numeric, and blob literals with "?" placeholders.
+func WithStatementSanitizer(sanitizer func(string) string) Option {
+	return func(o *traceOptions) {
+		o.sanitizer = sanitizer
+	}
+}
+
+// WithRawStatements disables sanitization of CQL statement text, recording
+// it verbatim, when passed true. Passing false disables statement capture
+// entirely: CassStatementKey and CassBatchStatementsKey are omitted from
+// spans, for deployments that can't tolerate any CQL text, sanitized or
+// not, reaching a trace backend.
+func WithRawStatements(raw bool) Option {
+	return func(o *traceOptions) {
+		if raw {
+			o.sanitizer = func(stmt string) string { return stmt }
+			o.captureStatements = true
+			return
+		}
+		o.captureStatements = false
+	}
+}
+
+// WithPayloadPropagation injects the current SpanContext into the outgoing
+// query/batch's custom payload using propagator, and attaches any keys
+// found in a query response's custom payload as span attributes; the
+// response payload of a batch is not read. This gives
+// operators end-to-end correlation between application spans and any
+// Cassandra-side tooling (triggers, custom coordinators, ScyllaDB tracing
+// plugins) that can read the payload, analogous to how HTTP and gRPC
+// integrations propagate context via headers. Payload propagation is
+// disabled by default, since not every cluster supports custom payloads.
+func WithPayloadPropagation(propagator propagation.Propagators) Option {
+	return func(o *traceOptions) {
+		o.propagator = propagator
+	}
+}
+
+// WithHostStateInstrumentation determines whether host up/down/add/remove
+// notifications are recorded as events on a long-lived "cassandra.cluster"
+// span, and as a host_state_changes counter metric, tagged with the host's
+// data center, rack, and host ID so multi-DC deployments can slice
+// telemetry by locality. Host state instrumentation is enabled by default.
+func WithHostStateInstrumentation(enabled bool) Option {
+	return func(o *traceOptions) {
+		o.hostStateInstrumentation = enabled
+	}
+}
+
+func newTraceOptions(opts ...Option) *traceOptions {
+	o := &traceOptions{
+		tracer:                   global.Tracer(instrumentationName),
+		meter:                    global.Meter(instrumentationName),
+		connectInstrumentation:   true,
+		hostStateInstrumentation: true,
+		captureStatements:        true,
+		sanitizer:                defaultStatementSanitizer,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Session wraps a *gocql.Session, instrumenting the queries, batches, and
+// connection attempts made through it.
+type Session struct {
+	*gocql.Session
+
+	options    *traceOptions
+	keyspace   string
+	hostPolicy *hostStatePolicy
+}
+
+// NewSessionWithTracing creates a new traced Session from the given cluster
+// configuration. It is a drop-in replacement for cluster.CreateSession.
+func NewSessionWithTracing(cluster *gocql.ClusterConfig, opts ...Option) (*Session, error) {
+	o := newTraceOptions(opts...)
+	instruments := newInstruments(o.meter)
+
+	if o.connectInstrumentation {
+		cluster.ConnectObserver = &tracedConnectObserver{
+			tracer:      o.tracer,
+			instruments: instruments,
+			next:        o.connectObserver,
+		}
+	} else if o.connectObserver != nil {
+		cluster.ConnectObserver = o.connectObserver
+	}
+	cluster.QueryObserver = &tracedQueryObserver{instruments: instruments, next: o.queryObserver}
+	cluster.BatchObserver = &tracedBatchObserver{instruments: instruments, next: o.batchObserver}
+
+	var hostPolicy *hostStatePolicy
+	if o.hostStateInstrumentation {
+		policy := cluster.PoolConfig.HostSelectionPolicy
+		if policy == nil {
+			policy = gocql.RoundRobinHostPolicy()
+		}
+		hostPolicy = newHostStatePolicy(o.tracer, instruments, policy)
+		cluster.PoolConfig.HostSelectionPolicy = hostPolicy
+	}
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Session{Session: session, options: o, keyspace: cluster.Keyspace, hostPolicy: hostPolicy}, nil
+}
+
+// Close closes the session and, if host state instrumentation was enabled,
+// ends the long-lived cluster span that host up/down/add/remove events were
+// recorded against, so it is flushed to the configured span processor.
+func (s *Session) Close() {
+	s.Session.Close()
+	if s.hostPolicy != nil {
+		s.hostPolicy.clusterSpan.End()
+	}
+}
+
+// Query wraps gocql.Session.Query so that the returned Query records a span
+// (and, if a meter was configured, metrics) around its execution. The
+// attributes describing the call (host, keyspace, error, ...) are filled in
+// by the query observer installed on the cluster, since that is the only
+// place gocql surfaces which host actually served the query.
+func (s *Session) Query(stmt string, values ...interface{}) *Query {
+	return &Query{
+		Query:    s.Session.Query(stmt, values...),
+		options:  s.options,
+		ctx:      context.Background(),
+		stmt:     stmt,
+		keyspace: s.keyspace,
+	}
+}
+
+// NewBatch wraps gocql.Session.NewBatch so that the returned Batch records a
+// span around ExecuteBatch.
+func (s *Session) NewBatch(typ gocql.BatchType) *Batch {
+	return &Batch{
+		Batch:    s.Session.NewBatch(typ),
+		options:  s.options,
+		ctx:      context.Background(),
+		keyspace: s.keyspace,
+	}
+}
+
+// ExecuteBatch executes a batch created by Session.NewBatch, recording a
+// span around the call.
+func (s *Session) ExecuteBatch(batch *Batch) error {
+	ctx, span := s.options.tracer.Start(batch.ctx, batchSpanName(batch.keyspace))
+	defer span.End()
+
+	span.SetAttributes(
+		DBOperationKey.String("BATCH"),
+		CassConsistencyKey.String(batch.Batch.GetConsistency().String()),
+	)
+
+	if s.options.captureStatements {
+		statements := make([]string, 0, len(batch.Batch.Entries))
+		for _, entry := range batch.Batch.Entries {
+			statements = append(statements, s.options.sanitizer(entry.Stmt))
+		}
+		span.SetAttributes(CassBatchStatementsKey.Array(statements))
+	}
+
+	if s.options.propagator != nil {
+		carrier := newPayloadCarrier(batch.Batch.CustomPayload)
+		propagation.InjectHTTP(ctx, s.options.propagator, carrier)
+		batch.Batch.CustomPayload = carrier.payload
+	}
+
+	batch.Batch = batch.Batch.WithContext(ctx)
+	return s.Session.ExecuteBatch(batch.Batch)
+}
+
+// Query wraps a *gocql.Query so that Exec and Iter are recorded as spans.
+type Query struct {
+	*gocql.Query
+
+	options  *traceOptions
+	ctx      context.Context
+	stmt     string
+	keyspace string
+}
+
+// WithContext changes q's context to ctx and returns q.
+// The provided context is used as the parent of the span created when the
+// query is executed.
+func (q *Query) WithContext(ctx context.Context) *Query {
+	q.ctx = ctx
+	q.Query = q.Query.WithContext(ctx)
+	return q
+}
+
+// startQuerySpan starts the span used by both Exec and Iter, named and
+// tagged from the query's own CQL text so backends can group queries by
+// operation and table rather than under one fixed span name.
+func (q *Query) startQuerySpan() (context.Context, trace.Span) {
+	operation := parseOperation(q.stmt)
+	table := parseTable(q.stmt)
+
+	ctx, span := q.options.tracer.Start(q.ctx, querySpanName(operation, q.keyspace, table))
+	if operation != "" {
+		span.SetAttributes(DBOperationKey.String(operation))
+	}
+	if table != "" {
+		span.SetAttributes(CassTableKey.String(table))
+	}
+	span.SetAttributes(CassConsistencyKey.String(q.Query.GetConsistency().String()))
+	if q.options.captureStatements {
+		span.SetAttributes(CassStatementKey.String(q.options.sanitizer(q.stmt)))
+	}
+	if q.options.propagator != nil {
+		carrier := newPayloadCarrier(nil)
+		propagation.InjectHTTP(ctx, q.options.propagator, carrier)
+		q.Query = q.Query.CustomPayload(carrier.payload)
+	}
+	return ctx, span
+}
+
+// Exec executes the query, recording a span around the call. If payload
+// propagation is enabled, any keys in the response's custom payload are
+// attached as span attributes.
+func (q *Query) Exec() error {
+	ctx, span := q.startQuerySpan()
+	defer span.End()
+
+	q.Query = q.Query.WithContext(ctx)
+	if q.options.propagator == nil {
+		return q.Query.Exec()
+	}
+	iter := q.Query.Iter()
+	span.SetAttributes(payloadAttributes(iter.GetCustomPayload())...)
+	return iter.Close()
+}
+
+// Iter executes the query and returns an iterator over the results,
+// recording a span around the call. The span ends once the first page of
+// results has been fetched, matching the point at which gocql itself
+// considers the query observed. If payload propagation is enabled, any
+// keys in the response's custom payload are attached as span attributes.
+func (q *Query) Iter() *gocql.Iter {
+	ctx, span := q.startQuerySpan()
+	defer span.End()
+
+	q.Query = q.Query.WithContext(ctx)
+	iter := q.Query.Iter()
+	if q.options.propagator != nil {
+		span.SetAttributes(payloadAttributes(iter.GetCustomPayload())...)
+	}
+	return iter
+}
+
+// Batch wraps a *gocql.Batch so that it can be passed to
+// Session.ExecuteBatch.
+type Batch struct {
+	*gocql.Batch
+
+	options  *traceOptions
+	ctx      context.Context
+	keyspace string
+}
+
+// WithContext changes b's context to ctx and returns b.
+// The provided context is used as the parent of the span created when the
+// batch is executed.
+func (b *Batch) WithContext(ctx context.Context) *Batch {
+	b.ctx = ctx
+	b.Batch = b.Batch.WithContext(ctx)
+	return b
+}
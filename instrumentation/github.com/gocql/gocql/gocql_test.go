@@ -41,6 +41,7 @@ func (m *mockConnectObserver) ObserveConnect(observedConnect gocql.ObservedConne
 }
 
 func TestQuery(t *testing.T) {
+	requireIntegration(t)
 	defer afterEach()
 	cluster := getCluster()
 	tracer := mocktracer.NewTracer("gocql-test")
@@ -77,11 +78,15 @@ func TestQuery(t *testing.T) {
 	assert.Greater(t, numberOfConnections, 0, "at least one connection needs to have been made")
 
 	// Verify attributes are correctly added to the spans. Omit the one local span
+	insertSpanName := fmt.Sprintf("Cassandra INSERT %s.%s", keyspace, tableName)
+
 	for _, span := range spans[0 : len(spans)-1] {
 
 		switch span.Name {
-		case cassQueryName:
+		case insertSpanName:
 			assert.Equal(t, insertStmt, span.Attributes[CassStatementKey].AsString())
+			assert.Equal(t, "INSERT", span.Attributes[DBOperationKey].AsString())
+			assert.Equal(t, tableName, span.Attributes[CassTableKey].AsString())
 			assert.Equal(t, parentSpan.SpanContext().SpanID.String(), span.ParentSpanID.String())
 			break
 		case cassConnectName:
@@ -100,6 +105,7 @@ func TestQuery(t *testing.T) {
 }
 
 func TestBatch(t *testing.T) {
+	requireIntegration(t)
 	defer afterEach()
 	cluster := getCluster()
 	tracer := mocktracer.NewTracer("gocql-test")
@@ -133,7 +139,7 @@ func TestBatch(t *testing.T) {
 	assert.Equal(t, 2, len(spans))
 	span := spans[0]
 
-	assert.Equal(t, cassBatchQueryName, span.Name)
+	assert.Equal(t, fmt.Sprintf("Cassandra BATCH %s", keyspace), span.Name)
 	assert.Equal(t, parentSpan.SpanContext().SpanID, span.ParentSpanID)
 	assert.NotNil(t, span.Attributes[CassVersionKey].AsString())
 	assert.Equal(t, cluster.Hosts[0], span.Attributes[CassHostKey].AsString())
@@ -144,6 +150,7 @@ func TestBatch(t *testing.T) {
 }
 
 func TestConnection(t *testing.T) {
+	requireIntegration(t)
 	defer afterEach()
 	cluster := getCluster()
 	tracer := mocktracer.NewTracer("gocql-test")
@@ -224,11 +231,17 @@ func afterEach() {
 	}
 }
 
-func TestMain(m *testing.M) {
+// requireIntegration skips t unless the INTEGRATION environment variable is
+// set, since the caller needs a live Cassandra cluster to run against.
+func requireIntegration(t *testing.T) {
 	if _, present := os.LookupEnv("INTEGRATION"); !present {
-		log.Print("--- SKIP: to enable integration test, set the INTEGRATION environment variable")
-		os.Exit(0)
+		t.Skip("skipping integration test: set the INTEGRATION environment variable to enable it")
+	}
+}
+
+func TestMain(m *testing.M) {
+	if _, present := os.LookupEnv("INTEGRATION"); present {
+		beforeAll()
 	}
-	beforeAll()
 	os.Exit(m.Run())
 }
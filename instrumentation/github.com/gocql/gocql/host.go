@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+
+	"go.opentelemetry.io/otel/api/kv"
+	"go.opentelemetry.io/otel/api/trace"
+)
+
+// topologyAttributes returns the span/metric attributes describing the
+// locality of host: its address, data center, rack, and host ID.
+func topologyAttributes(host *gocql.HostInfo) []kv.KeyValue {
+	attrs := hostAttributes(host)
+	if host == nil {
+		return attrs
+	}
+	return append(attrs,
+		CassDCKey.String(host.DataCenter()),
+		CassRackKey.String(host.Rack()),
+		CassHostIDKey.String(host.HostID()),
+	)
+}
+
+// hostStatePolicy wraps a gocql.HostSelectionPolicy, recording a span event
+// on a long-lived "cassandra.cluster" span, plus a counter metric, for
+// every host up/down/add/remove notification, while delegating host
+// selection itself to the wrapped policy unchanged.
+type hostStatePolicy struct {
+	gocql.HostSelectionPolicy
+
+	instruments *instruments
+	clusterCtx  context.Context
+	clusterSpan trace.Span
+}
+
+// newHostStatePolicy starts the long-lived cluster span used to host
+// topology-change events and wraps policy so its notifications are
+// observed.
+func newHostStatePolicy(tracer trace.Tracer, instruments *instruments, policy gocql.HostSelectionPolicy) *hostStatePolicy {
+	ctx, span := tracer.Start(context.Background(), cassClusterName)
+	return &hostStatePolicy{
+		HostSelectionPolicy: policy,
+		instruments:         instruments,
+		clusterCtx:          ctx,
+		clusterSpan:         span,
+	}
+}
+
+func (p *hostStatePolicy) recordEvent(name string, host *gocql.HostInfo) {
+	p.clusterSpan.AddEvent(p.clusterCtx, name, topologyAttributes(host)...)
+	p.instruments.recordHostState(name, host)
+}
+
+// HostUp notifies the wrapped policy after recording the event.
+func (p *hostStatePolicy) HostUp(host *gocql.HostInfo) {
+	p.recordEvent("host_up", host)
+	p.HostSelectionPolicy.HostUp(host)
+}
+
+// HostDown notifies the wrapped policy after recording the event.
+func (p *hostStatePolicy) HostDown(host *gocql.HostInfo) {
+	p.recordEvent("host_down", host)
+	p.HostSelectionPolicy.HostDown(host)
+}
+
+// AddHost notifies the wrapped policy after recording the event.
+func (p *hostStatePolicy) AddHost(host *gocql.HostInfo) {
+	p.recordEvent("host_added", host)
+	p.HostSelectionPolicy.AddHost(host)
+}
+
+// RemoveHost notifies the wrapped policy after recording the event.
+func (p *hostStatePolicy) RemoveHost(host *gocql.HostInfo) {
+	p.recordEvent("host_removed", host)
+	p.HostSelectionPolicy.RemoveHost(host)
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import (
+	"context"
+	"time"
+
+	"github.com/gocql/gocql"
+
+	"go.opentelemetry.io/otel/api/kv"
+	"go.opentelemetry.io/otel/api/metric"
+	"go.opentelemetry.io/otel/api/unit"
+)
+
+// dbOperationConnect is the db.operation value recorded for connection
+// attempts, which aren't associated with a CQL statement to parse a verb
+// from.
+const dbOperationConnect = "CONNECT"
+
+// instruments holds the metric instruments used to record RED-style
+// (rate, errors, duration) metrics for gocql operations. A zero-value
+// metric.Meter safely no-ops, so instruments built from one are valid too,
+// and call sites don't need to check whether a meter was configured.
+type instruments struct {
+	meter      metric.Meter
+	latency    metric.Float64ValueRecorder
+	queries    metric.Int64Counter
+	errors     metric.Int64Counter
+	hostEvents metric.Int64Counter
+}
+
+// newInstruments creates the instruments used by this package. If meter is
+// the zero value, the resulting instruments are no-ops, so no metrics are
+// recorded.
+func newInstruments(meter metric.Meter) *instruments {
+	m := metric.Must(meter)
+	return &instruments{
+		meter: meter,
+		latency: m.NewFloat64ValueRecorder(
+			"db.cassandra.latency",
+			metric.WithDescription("Latency of Cassandra calls made through this session"),
+			metric.WithUnit(unit.Milliseconds),
+		),
+		queries: m.NewInt64Counter(
+			"db.cassandra.calls",
+			metric.WithDescription("Number of Cassandra calls made through this session"),
+		),
+		errors: m.NewInt64Counter(
+			"db.cassandra.errors",
+			metric.WithDescription("Number of Cassandra calls that returned an error"),
+		),
+		hostEvents: m.NewInt64Counter(
+			"db.cassandra.host_state_changes",
+			metric.WithDescription("Number of host up/down/add/remove notifications observed"),
+		),
+	}
+}
+
+// record adds one observation of operation (a query, batch, or connect
+// attempt) against host in keyspace, started at start and finished at end,
+// to the configured instruments. err, if non-nil, is used both to tag the
+// observation and to increment the error counter.
+func (i *instruments) record(operation, keyspace string, start, end time.Time, host *gocql.HostInfo, err error) {
+	if i == nil {
+		return
+	}
+
+	labels := []kv.KeyValue{
+		DBSystemKey.String(dbSystemCassandra),
+		DBOperationKey.String(operation),
+	}
+	if keyspace != "" {
+		labels = append(labels, CassKeyspaceKey.String(keyspace))
+	}
+	if host != nil {
+		labels = append(labels, CassHostKey.String(hostPort(host)))
+	}
+	if err != nil {
+		labels = append(labels, DBErrorKey.String(classifyError(err)))
+	}
+
+	ctx := context.Background()
+	measurements := []metric.Measurement{
+		i.latency.Measurement(float64(end.Sub(start).Milliseconds())),
+		i.queries.Measurement(1),
+	}
+	if err != nil {
+		measurements = append(measurements, i.errors.Measurement(1))
+	}
+	i.meter.RecordBatch(ctx, labels, measurements...)
+}
+
+// recordHostState increments the host_state_changes counter for a
+// topology-change notification (host up/down/add/remove), tagged with the
+// host's address, data center, and rack.
+func (i *instruments) recordHostState(event string, host *gocql.HostInfo) {
+	if i == nil {
+		return
+	}
+	labels := append([]kv.KeyValue{
+		DBSystemKey.String(dbSystemCassandra),
+		DBOperationKey.String(event),
+	}, topologyAttributes(host)...)
+	i.meter.RecordBatch(context.Background(), labels, i.hostEvents.Measurement(1))
+}
+
+// classifyError buckets a gocql error into a small, low-cardinality class
+// suitable for use as a metric label, rather than recording the full error
+// string (which would blow up label cardinality).
+func classifyError(err error) string {
+	switch err {
+	case gocql.ErrTimeoutNoResponse, gocql.ErrConnectionClosed:
+		return "timeout"
+	case gocql.ErrNoConnections:
+		return "no_connections"
+	case gocql.ErrUnavailable:
+		return "unavailable"
+	case gocql.ErrTooManyTimeouts:
+		return "too_many_timeouts"
+	case gocql.ErrSessionClosed:
+		return "session_closed"
+	default:
+		return "other"
+	}
+}
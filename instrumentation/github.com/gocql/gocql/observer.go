@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gocql/gocql"
+	"google.golang.org/grpc/codes"
+
+	"go.opentelemetry.io/otel/api/kv"
+	"go.opentelemetry.io/otel/api/trace"
+)
+
+// hostAttributes returns the span attributes shared by every observer,
+// describing the host a connection, query, or batch was served by.
+func hostAttributes(host *gocql.HostInfo) []kv.KeyValue {
+	if host == nil {
+		return nil
+	}
+	return []kv.KeyValue{
+		CassHostKey.String(host.ConnectAddress().String()),
+		CassPortKey.Int32(int32(host.Port())),
+		CassHostStateKey.String(host.State().String()),
+		CassVersionKey.String(host.Version().String()),
+	}
+}
+
+// recordErr sets span status and the db error attribute when err is
+// non-nil. It is a no-op otherwise, leaving the span's default OK status.
+func recordErr(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.SetStatus(codes.Unknown, err.Error())
+	span.SetAttributes(DBErrorKey.String(classifyError(err)))
+}
+
+// tracedConnectObserver records a span and metrics for every connection
+// attempt gocql makes, then forwards the event to next, if set, so that
+// user-supplied observers keep working alongside this package's own
+// instrumentation.
+type tracedConnectObserver struct {
+	tracer      trace.Tracer
+	instruments *instruments
+	next        gocql.ConnectObserver
+}
+
+func (o *tracedConnectObserver) ObserveConnect(observed gocql.ObservedConnect) {
+	_, span := o.tracer.Start(context.Background(), cassConnectName)
+	span.SetAttributes(hostAttributes(observed.Host)...)
+	recordErr(span, observed.Err)
+	span.End()
+
+	o.instruments.record(dbOperationConnect, "", observed.Start, observed.End, observed.Host, observed.Err)
+
+	if o.next != nil {
+		o.next.ObserveConnect(observed)
+	}
+}
+
+// tracedQueryObserver fills in the span created by Query.Exec/Query.Iter
+// with the host that served the query (only known once gocql has picked a
+// connection), and records query metrics.
+type tracedQueryObserver struct {
+	instruments *instruments
+	next        gocql.QueryObserver
+}
+
+func (o *tracedQueryObserver) ObserveQuery(ctx context.Context, observed gocql.ObservedQuery) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(hostAttributes(observed.Host)...)
+	if observed.Keyspace != "" {
+		span.SetAttributes(
+			CassKeyspaceKey.String(observed.Keyspace),
+			DBNameKey.String(observed.Keyspace),
+		)
+	}
+	recordErr(span, observed.Err)
+
+	operation := parseOperation(observed.Statement)
+	o.instruments.record(operation, observed.Keyspace, observed.Start, observed.End, observed.Host, observed.Err)
+
+	if o.next != nil {
+		o.next.ObserveQuery(ctx, observed)
+	}
+}
+
+// tracedBatchObserver fills in the span created by Session.ExecuteBatch
+// with the host that served the batch, and records batch metrics.
+type tracedBatchObserver struct {
+	instruments *instruments
+	next        gocql.BatchObserver
+}
+
+func (o *tracedBatchObserver) ObserveBatch(ctx context.Context, observed gocql.ObservedBatch) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(hostAttributes(observed.Host)...)
+	if observed.Keyspace != "" {
+		span.SetAttributes(
+			CassKeyspaceKey.String(observed.Keyspace),
+			DBNameKey.String(observed.Keyspace),
+		)
+	}
+	recordErr(span, observed.Err)
+
+	o.instruments.record("BATCH", observed.Keyspace, observed.Start, observed.End, observed.Host, observed.Err)
+
+	if o.next != nil {
+		o.next.ObserveBatch(ctx, observed)
+	}
+}
+
+// hostPort renders a host's connect address and port as a single
+// "host:port" label value, used on metrics where a slice of attributes
+// isn't available.
+func hostPort(host *gocql.HostInfo) string {
+	if host == nil {
+		return ""
+	}
+	return host.ConnectAddress().String() + ":" + strconv.Itoa(host.Port())
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import "go.opentelemetry.io/otel/api/kv"
+
+// CassPayloadKeyPrefix prefixes span attributes recorded from keys found in
+// a Cassandra custom payload, e.g. a coordinator-side trace ID surfaced by
+// a custom ScyllaDB tracing plugin.
+const CassPayloadKeyPrefix = "db.cassandra.custom_payload."
+
+// payloadCarrier adapts a gocql custom payload (map[string][]byte) to the
+// propagation.HTTPSupplier interface, so SpanContext can be injected into,
+// and extracted from, outgoing and incoming query/batch payloads via
+// propagation.InjectHTTP/propagation.ExtractHTTP.
+type payloadCarrier struct {
+	payload map[string][]byte
+}
+
+func newPayloadCarrier(payload map[string][]byte) *payloadCarrier {
+	if payload == nil {
+		payload = make(map[string][]byte)
+	}
+	return &payloadCarrier{payload: payload}
+}
+
+// Get implements propagation.HTTPSupplier.
+func (c *payloadCarrier) Get(key string) string {
+	return string(c.payload[key])
+}
+
+// Set implements propagation.HTTPSupplier.
+func (c *payloadCarrier) Set(key, value string) {
+	c.payload[key] = []byte(value)
+}
+
+// payloadAttributes turns any keys in payload into span attributes, each
+// prefixed with CassPayloadKeyPrefix, so that values a custom coordinator
+// or tracing plugin attaches to its response are visible on the span
+// without the caller having to know about them ahead of time.
+func payloadAttributes(payload map[string][]byte) []kv.KeyValue {
+	if len(payload) == 0 {
+		return nil
+	}
+	attrs := make([]kv.KeyValue, 0, len(payload))
+	for k, v := range payload {
+		attrs = append(attrs, kv.Key(CassPayloadKeyPrefix+k).String(string(v)))
+	}
+	return attrs
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import "regexp"
+
+// Statement sanitizer is applied to CQL text before it is recorded as a
+// span attribute, so that literals baked into the statement (or
+// interpolated by the caller instead of bound as parameters) don't leak
+// into trace backends.
+var (
+	stringLiteralPattern  = regexp.MustCompile(`'(?:[^']|'')*'`)
+	hexLiteralPattern     = regexp.MustCompile(`(?i)0x[0-9a-f]+`)
+	numericLiteralPattern = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+)
+
+// defaultStatementSanitizer replaces string, numeric, and blob literals in
+// a CQL statement with "?" placeholders, similar to the query-obfuscation
+// techniques used by APM SQL integrations. It is a best-effort, regex-based
+// sanitizer: it is not a CQL parser and can be fooled by pathological
+// input, but it is sufficient to keep ordinary PII and secrets out of
+// span attributes.
+func defaultStatementSanitizer(stmt string) string {
+	stmt = stringLiteralPattern.ReplaceAllString(stmt, "?")
+	stmt = hexLiteralPattern.ReplaceAllString(stmt, "?")
+	stmt = numericLiteralPattern.ReplaceAllString(stmt, "?")
+	return stmt
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import "testing"
+
+func TestDefaultStatementSanitizer(t *testing.T) {
+	tests := []struct {
+		name string
+		stmt string
+		want string
+	}{
+		{
+			"string literal",
+			"insert into test_table (id, title) values (?, 'example-title')",
+			"insert into test_table (id, title) values (?, ?)",
+		},
+		{
+			"escaped quote in string literal",
+			"insert into test_table (id, title) values (?, 'it''s a title')",
+			"insert into test_table (id, title) values (?, ?)",
+		},
+		{
+			"numeric literal",
+			"select * from test_table where id = 42",
+			"select * from test_table where id = ?",
+		},
+		{
+			"decimal literal",
+			"select * from test_table where score = 3.14",
+			"select * from test_table where score = ?",
+		},
+		{
+			"hex literal",
+			"insert into test_table (id, payload) values (?, 0xDEADBEEF)",
+			"insert into test_table (id, payload) values (?, ?)",
+		},
+		{
+			"no literals",
+			"select * from test_table where id = ?",
+			"select * from test_table where id = ?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := defaultStatementSanitizer(tt.stmt); got != tt.want {
+				t.Errorf("defaultStatementSanitizer(%q) = %q, want %q", tt.stmt, got, tt.want)
+			}
+		})
+	}
+}
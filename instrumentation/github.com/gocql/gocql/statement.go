@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import (
+	"regexp"
+	"strings"
+)
+
+// leadingVerbPattern matches the first CQL keyword of a statement, e.g.
+// SELECT, INSERT, UPDATE, DELETE, CREATE, USE.
+var leadingVerbPattern = regexp.MustCompile(`(?i)^\s*(\w+)`)
+
+// tablePattern matches the table a statement targets, following FROM,
+// INTO, or UPDATE.
+var tablePattern = regexp.MustCompile(`(?i)\b(?:from|into|update)\s+([\w.]+)`)
+
+// parseOperation extracts the leading CQL verb of stmt (SELECT, INSERT,
+// UPDATE, ...), upper-cased. It returns the empty string if stmt doesn't
+// start with a recognizable word, e.g. for an empty statement.
+func parseOperation(stmt string) string {
+	match := leadingVerbPattern.FindStringSubmatch(stmt)
+	if match == nil {
+		return ""
+	}
+	return strings.ToUpper(match[1])
+}
+
+// parseTable extracts the table targeted by stmt, i.e. the identifier
+// following FROM, INTO, or UPDATE. It returns the empty string if no table
+// could be identified, which is expected for statements like USE.
+func parseTable(stmt string) string {
+	match := tablePattern.FindStringSubmatch(stmt)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// querySpanName builds a low-cardinality span name of the form
+// "Cassandra <OPERATION> <keyspace>.<table>" so that backends can group
+// spans meaningfully. It falls back to the generic cassQueryName when the
+// operation can't be determined, and to "Cassandra <OPERATION>" when only
+// the table can't be determined.
+func querySpanName(operation, keyspace, table string) string {
+	if operation == "" {
+		return cassQueryName
+	}
+	if table == "" {
+		return "Cassandra " + operation
+	}
+	if keyspace == "" {
+		return "Cassandra " + operation + " " + table
+	}
+	if strings.Contains(table, ".") {
+		return "Cassandra " + operation + " " + table
+	}
+	return "Cassandra " + operation + " " + keyspace + "." + table
+}
+
+// batchSpanName builds the span name used for Session.ExecuteBatch.
+func batchSpanName(keyspace string) string {
+	if keyspace == "" {
+		return cassBatchQueryName
+	}
+	return "Cassandra BATCH " + keyspace
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gocql
+
+import "testing"
+
+func TestParseOperation(t *testing.T) {
+	tests := []struct {
+		name string
+		stmt string
+		want string
+	}{
+		{"select", "select * from test_table where id = ?", "SELECT"},
+		{"insert", "insert into test_table (id, title) values (?, ?)", "INSERT"},
+		{"lowercase", "update test_table set title = ? where id = ?", "UPDATE"},
+		{"leading whitespace", "  delete from test_table where id = ?", "DELETE"},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseOperation(tt.stmt); got != tt.want {
+				t.Errorf("parseOperation(%q) = %q, want %q", tt.stmt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTable(t *testing.T) {
+	tests := []struct {
+		name string
+		stmt string
+		want string
+	}{
+		{"select from", "select * from test_table where id = ?", "test_table"},
+		{"insert into", "insert into test_table (id, title) values (?, ?)", "test_table"},
+		{"update", "update test_table set title = ? where id = ?", "test_table"},
+		{"keyspace qualified", "select * from gotest.test_table", "gotest.test_table"},
+		{"no table", "use gotest", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseTable(tt.stmt); got != tt.want {
+				t.Errorf("parseTable(%q) = %q, want %q", tt.stmt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuerySpanName(t *testing.T) {
+	tests := []struct {
+		name                       string
+		operation, keyspace, table string
+		want                       string
+	}{
+		{"no operation", "", "gotest", "test_table", cassQueryName},
+		{"no table", "SELECT", "gotest", "", "Cassandra SELECT"},
+		{"no keyspace", "SELECT", "", "test_table", "Cassandra SELECT test_table"},
+		{"keyspace qualified table", "SELECT", "gotest", "other.test_table", "Cassandra SELECT other.test_table"},
+		{"full", "INSERT", "gotest", "test_table", "Cassandra INSERT gotest.test_table"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := querySpanName(tt.operation, tt.keyspace, tt.table); got != tt.want {
+				t.Errorf("querySpanName(%q, %q, %q) = %q, want %q", tt.operation, tt.keyspace, tt.table, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBatchSpanName(t *testing.T) {
+	tests := []struct {
+		name     string
+		keyspace string
+		want     string
+	}{
+		{"no keyspace", "", cassBatchQueryName},
+		{"with keyspace", "gotest", "Cassandra BATCH gotest"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := batchSpanName(tt.keyspace); got != tt.want {
+				t.Errorf("batchSpanName(%q) = %q, want %q", tt.keyspace, got, tt.want)
+			}
+		})
+	}
+}